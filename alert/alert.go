@@ -0,0 +1,16 @@
+package alert
+
+import "github.com/henrriusdev/scrapport/scraper"
+
+// Alert is a single firing of a Rule against the market that satisfied it.
+type Alert struct {
+	Rule   string
+	Market scraper.Market
+}
+
+// Transport delivers a fired Alert to the outside world: MQTT, a webhook, a
+// desktop notification, or a chat app.
+type Transport interface {
+	Name() string
+	Send(a Alert) error
+}
@@ -0,0 +1,106 @@
+package alert
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ruleConfig is the YAML-facing shape of a Rule; Condition and Trigger are
+// written as short strings so the config reads like the rule it describes.
+type ruleConfig struct {
+	Name      string  `yaml:"name"`
+	Game      string  `yaml:"game"`
+	BetType   string  `yaml:"bet_type"`
+	Side      string  `yaml:"side"`
+	Line      float64 `yaml:"line"`
+	Condition string  `yaml:"condition"` // "gt" (default) or "lt"
+	Threshold float64 `yaml:"threshold"`
+	Trigger   string  `yaml:"trigger"` // "repeating" (default) or "one_shot"
+	Cooldown  string  `yaml:"cooldown"`
+}
+
+// Config is the top-level alert configuration: one entry per rule.
+type Config struct {
+	Rules []ruleConfig `yaml:"rules"`
+}
+
+// LoadRules reads and parses a rules config file into Rules ready to evaluate.
+func LoadRules(path string) ([]Rule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("alert: reading config: %w", err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("alert: parsing config: %w", err)
+	}
+
+	rules := make([]Rule, 0, len(cfg.Rules))
+	for _, rc := range cfg.Rules {
+		rule, err := rc.toRule()
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func (rc ruleConfig) toRule() (Rule, error) {
+	condition, err := parseCondition(rc.Condition)
+	if err != nil {
+		return Rule{}, fmt.Errorf("alert: rule %q: %w", rc.Name, err)
+	}
+
+	trigger, err := parseTrigger(rc.Trigger)
+	if err != nil {
+		return Rule{}, fmt.Errorf("alert: rule %q: %w", rc.Name, err)
+	}
+
+	var cooldown time.Duration
+	if rc.Cooldown != "" {
+		cooldown, err = time.ParseDuration(rc.Cooldown)
+		if err != nil {
+			return Rule{}, fmt.Errorf("alert: rule %q: invalid cooldown: %w", rc.Name, err)
+		}
+	}
+
+	return Rule{
+		Name:      rc.Name,
+		Game:      rc.Game,
+		BetType:   rc.BetType,
+		Side:      rc.Side,
+		Line:      rc.Line,
+		Condition: condition,
+		Threshold: rc.Threshold,
+		Trigger:   trigger,
+		Cooldown:  cooldown,
+	}, nil
+}
+
+func parseCondition(s string) (Condition, error) {
+	switch s {
+	case "", "gt":
+		return GreaterThan, nil
+	case "lt":
+		return LessThan, nil
+	default:
+		return 0, fmt.Errorf("unknown condition %q", s)
+	}
+}
+
+func parseTrigger(s string) (Trigger, error) {
+	switch s {
+	case "", "repeating":
+		return Repeating, nil
+	case "one_shot":
+		return OneShot, nil
+	default:
+		return 0, fmt.Errorf("unknown trigger %q", s)
+	}
+}
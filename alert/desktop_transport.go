@@ -0,0 +1,19 @@
+package alert
+
+import (
+	"fmt"
+
+	"github.com/gen2brain/beeep"
+)
+
+// DesktopTransport shows each alert as a native desktop notification.
+type DesktopTransport struct{}
+
+// Name identifies the transport in logs.
+func (DesktopTransport) Name() string { return "desktop" }
+
+// Send shows a as a desktop notification titled after the rule that fired.
+func (DesktopTransport) Send(a Alert) error {
+	message := fmt.Sprintf("%s: %s %s %s @ %+.0f", a.Market.Book, a.Market.Game, a.Market.BetType, a.Market.Side, a.Market.Odds)
+	return beeep.Notify(a.Rule, message, "")
+}
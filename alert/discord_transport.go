@@ -0,0 +1,49 @@
+package alert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// DiscordTransport posts each alert to a Discord incoming webhook.
+type DiscordTransport struct {
+	WebhookURL string
+	Client     *http.Client
+}
+
+// NewDiscordTransport builds a DiscordTransport posting to webhookURL using http.DefaultClient.
+func NewDiscordTransport(webhookURL string) *DiscordTransport {
+	return &DiscordTransport{WebhookURL: webhookURL, Client: http.DefaultClient}
+}
+
+// Name identifies the transport in logs.
+func (t *DiscordTransport) Name() string { return "discord" }
+
+// Send posts a to the Discord webhook as a chat message.
+func (t *DiscordTransport) Send(a Alert) error {
+	body, err := json.Marshal(struct {
+		Content string `json:"content"`
+	}{Content: formatAlert(a)})
+	if err != nil {
+		return fmt.Errorf("marshaling discord payload: %w", err)
+	}
+
+	resp, err := t.Client.Post(t.WebhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("posting to discord: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("discord returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func formatAlert(a Alert) string {
+	return fmt.Sprintf("**%s** — %s: %s %s %s @ %+.0f",
+		a.Rule, a.Market.Book, a.Market.Game, a.Market.BetType, a.Market.Side, a.Market.Odds)
+}
@@ -0,0 +1,72 @@
+package alert
+
+import (
+	"log"
+	"time"
+
+	"github.com/henrriusdev/scrapport/scraper"
+)
+
+// fireState tracks per-rule firing history for one-shot disabling and cooldowns.
+type fireState struct {
+	fired    bool
+	lastFire time.Time
+}
+
+// Evaluator checks a set of Rules against fresh markets each tick and
+// dispatches an Alert through every transport for each rule that newly fires.
+type Evaluator struct {
+	rules      []Rule
+	transports []Transport
+
+	state map[string]*fireState // keyed by Rule.Name
+	now   func() time.Time
+}
+
+// NewEvaluator builds an Evaluator for rules, dispatching fired alerts to transports.
+func NewEvaluator(rules []Rule, transports ...Transport) *Evaluator {
+	return &Evaluator{
+		rules:      rules,
+		transports: transports,
+		state:      make(map[string]*fireState, len(rules)),
+		now:        time.Now,
+	}
+}
+
+// Evaluate checks every rule against markets, dispatching an Alert for each
+// rule that newly fires, honoring its one-shot/repeating trigger and cooldown.
+func (e *Evaluator) Evaluate(markets []scraper.Market) {
+	for _, rule := range e.rules {
+		st := e.state[rule.Name]
+		if st == nil {
+			st = &fireState{}
+			e.state[rule.Name] = st
+		}
+
+		if rule.Trigger == OneShot && st.fired {
+			continue
+		}
+		if rule.Cooldown > 0 && e.now().Sub(st.lastFire) < rule.Cooldown {
+			continue
+		}
+
+		for _, m := range markets {
+			if !rule.Matches(m) || !rule.Holds(m) {
+				continue
+			}
+
+			st.fired = true
+			st.lastFire = e.now()
+			e.dispatch(Alert{Rule: rule.Name, Market: m})
+			break // one match is enough to fire the rule for this tick
+		}
+	}
+}
+
+func (e *Evaluator) dispatch(a Alert) {
+	for _, t := range e.transports {
+		if err := t.Send(a); err != nil {
+			log.Printf("alert: %s: %v\n", t.Name(), err)
+		}
+	}
+}
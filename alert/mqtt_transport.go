@@ -0,0 +1,46 @@
+package alert
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// MQTTTransport publishes each alert as JSON to a fixed topic on a broker.
+type MQTTTransport struct {
+	client mqtt.Client
+	topic  string
+}
+
+// NewMQTTTransport connects to brokerURL (e.g. "tcp://localhost:1883") and
+// returns a transport that publishes alerts to topic.
+func NewMQTTTransport(brokerURL, topic string) (*MQTTTransport, error) {
+	opts := mqtt.NewClientOptions().AddBroker(brokerURL).SetClientID("scrapport-alert")
+
+	client := mqtt.NewClient(opts)
+	if token := client.Connect(); token.Wait() && token.Error() != nil {
+		return nil, fmt.Errorf("alert: connecting to mqtt broker: %w", token.Error())
+	}
+
+	return &MQTTTransport{client: client, topic: topic}, nil
+}
+
+// Name identifies the transport in logs.
+func (t *MQTTTransport) Name() string { return "mqtt" }
+
+// Send publishes a as JSON to the transport's topic.
+func (t *MQTTTransport) Send(a Alert) error {
+	payload, err := json.Marshal(a)
+	if err != nil {
+		return fmt.Errorf("alert: marshaling: %w", err)
+	}
+
+	token := t.client.Publish(t.topic, 1, false, payload)
+	if !token.WaitTimeout(5*time.Second) || token.Error() != nil {
+		return fmt.Errorf("alert: publishing to mqtt: %w", token.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+// Package alert evaluates user-defined odds conditions against fresh markets
+// and dispatches notifications through pluggable transports.
+package alert
+
+import (
+	"strings"
+	"time"
+
+	"github.com/henrriusdev/scrapport/scraper"
+)
+
+// Trigger selects how a Rule behaves once its condition is met.
+type Trigger int
+
+const (
+	// Repeating fires every tick its condition holds, subject to Cooldown.
+	Repeating Trigger = iota
+	// OneShot fires at most once, then disables itself.
+	OneShot
+)
+
+// Condition is the comparison a Rule's Threshold is evaluated against.
+type Condition int
+
+const (
+	GreaterThan Condition = iota
+	LessThan
+)
+
+// Rule is a user-defined condition evaluated against fresh markets each tick,
+// e.g. "alert PHI-ML if odds > +150" or "alert TOTAL 45.5 if over-odds > -105".
+type Rule struct {
+	Name      string
+	Game      string // substring match against Market.Game, e.g. "PHI"
+	BetType   string // exact match against Market.BetType, e.g. "Moneyline"
+	Side      string // exact match against Market.Side, e.g. "over"
+	Line      float64
+	Condition Condition
+	Threshold float64
+	Trigger   Trigger
+	Cooldown  time.Duration // minimum time between fires for a Repeating rule
+}
+
+// Matches reports whether m is a market this rule watches.
+func (r Rule) Matches(m scraper.Market) bool {
+	if r.Game != "" && !strings.Contains(strings.ToLower(m.Game), strings.ToLower(r.Game)) {
+		return false
+	}
+	if r.BetType != "" && !strings.EqualFold(r.BetType, m.BetType) {
+		return false
+	}
+	if r.Side != "" && !strings.EqualFold(r.Side, m.Side) {
+		return false
+	}
+	if r.Line != 0 && m.Line != r.Line {
+		return false
+	}
+	return true
+}
+
+// Holds reports whether m's odds satisfy the rule's condition.
+func (r Rule) Holds(m scraper.Market) bool {
+	if r.Condition == LessThan {
+		return m.Odds < r.Threshold
+	}
+	return m.Odds > r.Threshold
+}
@@ -0,0 +1,44 @@
+package alert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SlackTransport posts each alert to a Slack incoming webhook.
+type SlackTransport struct {
+	WebhookURL string
+	Client     *http.Client
+}
+
+// NewSlackTransport builds a SlackTransport posting to webhookURL using http.DefaultClient.
+func NewSlackTransport(webhookURL string) *SlackTransport {
+	return &SlackTransport{WebhookURL: webhookURL, Client: http.DefaultClient}
+}
+
+// Name identifies the transport in logs.
+func (t *SlackTransport) Name() string { return "slack" }
+
+// Send posts a to the Slack webhook as a chat message.
+func (t *SlackTransport) Send(a Alert) error {
+	body, err := json.Marshal(struct {
+		Text string `json:"text"`
+	}{Text: formatAlert(a)})
+	if err != nil {
+		return fmt.Errorf("marshaling slack payload: %w", err)
+	}
+
+	resp, err := t.Client.Post(t.WebhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("posting to slack: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("slack returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
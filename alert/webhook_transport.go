@@ -0,0 +1,42 @@
+package alert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// WebhookTransport POSTs each alert as JSON to a configured URL.
+type WebhookTransport struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewWebhookTransport builds a WebhookTransport that posts to url using http.DefaultClient.
+func NewWebhookTransport(url string) *WebhookTransport {
+	return &WebhookTransport{URL: url, Client: http.DefaultClient}
+}
+
+// Name identifies the transport in logs.
+func (t *WebhookTransport) Name() string { return "webhook" }
+
+// Send POSTs a as JSON to the transport's URL.
+func (t *WebhookTransport) Send(a Alert) error {
+	body, err := json.Marshal(a)
+	if err != nil {
+		return fmt.Errorf("marshaling alert: %w", err)
+	}
+
+	resp, err := t.Client.Post(t.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("posting to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package arbitrage
+
+import (
+	"sort"
+
+	"github.com/henrriusdev/scrapport/scraper"
+)
+
+// Detector finds two-way arbitrage opportunities across markets scraped from
+// multiple sportsbooks.
+type Detector struct {
+	// LineTolerance is the maximum absolute difference between two legs' lines
+	// that still counts as a pairing, for spread/total markets where books quote
+	// slightly different lines. A pair with differing lines is flagged as a
+	// Middle rather than a pure arbitrage. Moneylines always require an exact
+	// (zero) match regardless of this setting.
+	LineTolerance float64
+	// TotalStake is the bankroll each opportunity's stake allocation is split across.
+	TotalStake float64
+}
+
+// NewDetector builds a Detector with the given line tolerance and total stake.
+func NewDetector(lineTolerance, totalStake float64) *Detector {
+	return &Detector{LineTolerance: lineTolerance, TotalStake: totalStake}
+}
+
+// Detect scans markets for two-way arbitrage opportunities (moneyline, spread,
+// total), one per game+bet-type+line pairing, and returns them sorted by
+// descending margin. A single game+bet-type group can surface more than one
+// opportunity when multiple lines are independently profitable (e.g. a +3
+// and a +3.5 spread both arb against different books).
+func (d *Detector) Detect(markets []scraper.Market) []Opportunity {
+	var opportunities []Opportunity
+
+	for _, g := range groupByGameAndBetType(markets) {
+		opportunities = append(opportunities, d.bestPerLineInGroup(g)...)
+	}
+
+	sort.Slice(opportunities, func(i, j int) bool {
+		return opportunities[i].Margin > opportunities[j].Margin
+	})
+
+	return opportunities
+}
+
+type marketGroup struct {
+	game    string
+	betType string
+	markets []scraper.Market
+}
+
+func groupByGameAndBetType(markets []scraper.Market) []marketGroup {
+	index := make(map[string]int)
+	var groups []marketGroup
+
+	for _, m := range markets {
+		if m.Odds == 0 {
+			continue
+		}
+
+		key := m.Game + "|" + m.BetType
+		if i, ok := index[key]; ok {
+			groups[i].markets = append(groups[i].markets, m)
+			continue
+		}
+
+		index[key] = len(groups)
+		groups = append(groups, marketGroup{game: m.Game, betType: m.BetType, markets: []scraper.Market{m}})
+	}
+
+	return groups
+}
+
+// bestPerLineInGroup finds the lowest-S leg pairing for every distinct line
+// offered within a single game+bet-type group, minimizing independently over
+// each line's combinations of books and sides and honoring the tolerance for
+// mismatched lines. Each line a book offers is its own bucket, so an arb at
+// one line doesn't shadow an equally real arb at another.
+func (d *Detector) bestPerLineInGroup(g marketGroup) []Opportunity {
+	var overs, unders []scraper.Market
+	for _, m := range g.markets {
+		switch m.Side {
+		case "over":
+			overs = append(overs, m)
+		case "under":
+			unders = append(unders, m)
+		}
+	}
+
+	tolerance := d.LineTolerance
+	if g.betType == "Moneyline" {
+		tolerance = 0
+	}
+
+	index := make(map[float64]int)
+	var bestByLine []*Opportunity
+
+	for _, o := range overs {
+		for _, u := range unders {
+			if o.Book == u.Book {
+				continue
+			}
+
+			lineDiff := o.Line - u.Line
+			if lineDiff < 0 {
+				lineDiff = -lineDiff
+			}
+			if lineDiff > tolerance {
+				continue
+			}
+
+			dOver := AmericanToDecimal(o.Odds)
+			dUnder := AmericanToDecimal(u.Odds)
+			s := 1/dOver + 1/dUnder
+			if s >= 1 {
+				continue
+			}
+
+			i, ok := index[o.Line]
+			if !ok {
+				i = len(bestByLine)
+				index[o.Line] = i
+				bestByLine = append(bestByLine, nil)
+			}
+
+			margin := 1 - s
+			if bestByLine[i] != nil && margin <= bestByLine[i].Margin {
+				continue
+			}
+
+			bestByLine[i] = &Opportunity{
+				Game:    g.game,
+				BetType: g.betType,
+				Line:    o.Line,
+				Legs: []Leg{
+					{Book: o.Book, Side: o.Side, Odds: o.Odds, Line: o.Line},
+					{Book: u.Book, Side: u.Side, Odds: u.Odds, Line: u.Line},
+				},
+				Margin: margin,
+				Stakes: []float64{
+					d.TotalStake * (1 / dOver) / s,
+					d.TotalStake * (1 / dUnder) / s,
+				},
+				Middle: lineDiff > 0,
+			}
+		}
+	}
+
+	opportunities := make([]Opportunity, 0, len(bestByLine))
+	for _, o := range bestByLine {
+		opportunities = append(opportunities, *o)
+	}
+
+	return opportunities
+}
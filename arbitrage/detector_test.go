@@ -0,0 +1,142 @@
+package arbitrage
+
+import (
+	"testing"
+
+	"github.com/henrriusdev/scrapport/scraper"
+)
+
+func TestDetectFindsArbitrageAcrossBooks(t *testing.T) {
+	d := NewDetector(0, 100)
+
+	markets := []scraper.Market{
+		{Book: "draftkings", Game: "A vs B", BetType: "Moneyline", Side: "over", Odds: 150, Line: 0},
+		{Book: "fanduel", Game: "A vs B", BetType: "Moneyline", Side: "under", Odds: 150, Line: 0},
+	}
+
+	got := d.Detect(markets)
+	if len(got) != 1 {
+		t.Fatalf("Detect() returned %d opportunities, want 1", len(got))
+	}
+
+	opp := got[0]
+	dOver := AmericanToDecimal(150)
+	dUnder := AmericanToDecimal(150)
+	s := 1/dOver + 1/dUnder
+	wantMargin := 1 - s
+
+	if !almostEqual(opp.Margin, wantMargin) {
+		t.Errorf("Margin = %v, want %v", opp.Margin, wantMargin)
+	}
+	if opp.Middle {
+		t.Error("Middle = true, want false for a zero-tolerance moneyline pair")
+	}
+
+	wantStakes := []float64{100 * (1 / dOver) / s, 100 * (1 / dUnder) / s}
+	if len(opp.Stakes) != 2 || !almostEqual(opp.Stakes[0], wantStakes[0]) || !almostEqual(opp.Stakes[1], wantStakes[1]) {
+		t.Errorf("Stakes = %v, want %v", opp.Stakes, wantStakes)
+	}
+	if sum := opp.Stakes[0] + opp.Stakes[1]; !almostEqual(sum, 100) {
+		t.Errorf("Stakes sum to %v, want TotalStake 100", sum)
+	}
+}
+
+func TestDetectRejectsNonArbitrage(t *testing.T) {
+	d := NewDetector(0, 100)
+
+	markets := []scraper.Market{
+		{Book: "draftkings", Game: "A vs B", BetType: "Moneyline", Side: "over", Odds: -150, Line: 0},
+		{Book: "fanduel", Game: "A vs B", BetType: "Moneyline", Side: "under", Odds: -150, Line: 0},
+	}
+
+	if got := d.Detect(markets); len(got) != 0 {
+		t.Fatalf("Detect() returned %d opportunities, want 0 (S >= 1)", len(got))
+	}
+}
+
+func TestDetectMoneylineIgnoresLineTolerance(t *testing.T) {
+	d := NewDetector(1, 100)
+
+	markets := []scraper.Market{
+		{Book: "draftkings", Game: "A vs B", BetType: "Moneyline", Side: "over", Odds: 150, Line: 0},
+		{Book: "fanduel", Game: "A vs B", BetType: "Moneyline", Side: "under", Odds: 150, Line: 0.5},
+	}
+
+	if got := d.Detect(markets); len(got) != 0 {
+		t.Fatalf("Detect() returned %d opportunities, want 0 (moneylines must match exactly)", len(got))
+	}
+}
+
+func TestDetectFlagsMismatchedLinesAsMiddle(t *testing.T) {
+	d := NewDetector(0.5, 100)
+
+	markets := []scraper.Market{
+		{Book: "draftkings", Game: "A vs B", BetType: "Total", Side: "over", Odds: 110, Line: 45},
+		{Book: "fanduel", Game: "A vs B", BetType: "Total", Side: "under", Odds: 110, Line: 45.5},
+	}
+
+	got := d.Detect(markets)
+	if len(got) != 1 {
+		t.Fatalf("Detect() returned %d opportunities, want 1", len(got))
+	}
+	if !got[0].Middle {
+		t.Error("Middle = false, want true for lines within tolerance but not equal")
+	}
+}
+
+func TestDetectRejectsLinesOutsideTolerance(t *testing.T) {
+	d := NewDetector(0.5, 100)
+
+	markets := []scraper.Market{
+		{Book: "draftkings", Game: "A vs B", BetType: "Total", Side: "over", Odds: 110, Line: 45},
+		{Book: "fanduel", Game: "A vs B", BetType: "Total", Side: "under", Odds: 110, Line: 46},
+	}
+
+	if got := d.Detect(markets); len(got) != 0 {
+		t.Fatalf("Detect() returned %d opportunities, want 0 (line diff exceeds tolerance)", len(got))
+	}
+}
+
+func TestDetectSurfacesIndependentArbsAtDifferentLines(t *testing.T) {
+	d := NewDetector(0, 100)
+
+	markets := []scraper.Market{
+		{Book: "draftkings", Game: "A vs B", BetType: "Spread", Side: "over", Odds: 120, Line: 3},
+		{Book: "fanduel", Game: "A vs B", BetType: "Spread", Side: "under", Odds: 120, Line: 3},
+		{Book: "betmgm", Game: "A vs B", BetType: "Spread", Side: "over", Odds: 200, Line: 3.5},
+		{Book: "pinnacle", Game: "A vs B", BetType: "Spread", Side: "under", Odds: 200, Line: 3.5},
+	}
+
+	got := d.Detect(markets)
+	if len(got) != 2 {
+		t.Fatalf("Detect() returned %d opportunities, want 2 (one per distinct line)", len(got))
+	}
+
+	byLine := make(map[float64]Opportunity, len(got))
+	for _, o := range got {
+		byLine[o.Line] = o
+	}
+
+	if _, ok := byLine[3]; !ok {
+		t.Error("missing opportunity at line 3")
+	}
+	if _, ok := byLine[3.5]; !ok {
+		t.Error("missing opportunity at line 3.5")
+	}
+	if len(got) == 2 && got[0].Margin < got[1].Margin {
+		t.Errorf("opportunities not sorted by descending margin: %v", got)
+	}
+}
+
+func TestDetectIgnoresSameBookPairing(t *testing.T) {
+	d := NewDetector(0, 100)
+
+	markets := []scraper.Market{
+		{Book: "draftkings", Game: "A vs B", BetType: "Moneyline", Side: "over", Odds: 150, Line: 0},
+		{Book: "draftkings", Game: "A vs B", BetType: "Moneyline", Side: "under", Odds: 150, Line: 0},
+	}
+
+	if got := d.Detect(markets); len(got) != 0 {
+		t.Fatalf("Detect() returned %d opportunities, want 0 (same book can't be both legs)", len(got))
+	}
+}
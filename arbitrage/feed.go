@@ -0,0 +1,35 @@
+package arbitrage
+
+import (
+	"log"
+
+	"github.com/henrriusdev/scrapport/scraper"
+)
+
+// Feed detects opportunities in a batch of markets and publishes each one to
+// every subscribed Sink.
+type Feed struct {
+	Detector *Detector
+	Sinks    []Sink
+}
+
+// NewFeed builds a Feed around detector, publishing to the given sinks.
+func NewFeed(detector *Detector, sinks ...Sink) *Feed {
+	return &Feed{Detector: detector, Sinks: sinks}
+}
+
+// Process detects opportunities in markets and publishes each to every sink. A
+// sink error is logged but does not stop the other sinks from receiving it.
+func (f *Feed) Process(markets []scraper.Market) []Opportunity {
+	opportunities := f.Detector.Detect(markets)
+
+	for _, o := range opportunities {
+		for _, sink := range f.Sinks {
+			if err := sink.Publish(o); err != nil {
+				log.Printf("arbitrage: sink error: %v\n", err)
+			}
+		}
+	}
+
+	return opportunities
+}
@@ -0,0 +1,13 @@
+package arbitrage
+
+import "log"
+
+// LogSink publishes opportunities to the standard logger.
+type LogSink struct{}
+
+// Publish logs o as a single line summarizing the game, margin, and legs.
+func (LogSink) Publish(o Opportunity) error {
+	log.Printf("arb: %s %s line %.1f margin %.2f%% legs=%v stakes=%v\n",
+		o.Game, o.BetType, o.Line, o.Margin*100, o.Legs, o.Stakes)
+	return nil
+}
@@ -0,0 +1,10 @@
+package arbitrage
+
+// AmericanToDecimal converts American odds to decimal odds:
+// d = 1 + odds/100 for positive odds, d = 1 + 100/|odds| for negative odds.
+func AmericanToDecimal(american float64) float64 {
+	if american > 0 {
+		return 1 + american/100
+	}
+	return 1 + 100/-american
+}
@@ -0,0 +1,33 @@
+package arbitrage
+
+import "testing"
+
+func TestAmericanToDecimal(t *testing.T) {
+	tests := []struct {
+		name     string
+		american float64
+		want     float64
+	}{
+		{"positive", 150, 2.5},
+		{"negative", -110, 1 + 100.0/110},
+		{"negative round number", -200, 1.5},
+		{"even money", 100, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AmericanToDecimal(tt.american); !almostEqual(got, tt.want) {
+				t.Errorf("AmericanToDecimal(%v) = %v, want %v", tt.american, got, tt.want)
+			}
+		})
+	}
+}
+
+func almostEqual(a, b float64) bool {
+	const epsilon = 1e-9
+	d := a - b
+	if d < 0 {
+		d = -d
+	}
+	return d < epsilon
+}
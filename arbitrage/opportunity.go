@@ -0,0 +1,22 @@
+package arbitrage
+
+// Leg is one side of an opportunity: the book, side, line, and American odds
+// backing it.
+type Leg struct {
+	Book string
+	Side string
+	Odds float64
+	Line float64
+}
+
+// Opportunity is a pairing of legs from different books whose combined implied
+// probability S is under 1, guaranteeing a profit of Margin regardless of outcome.
+type Opportunity struct {
+	Game    string
+	BetType string
+	Line    float64 // the first leg's line; legs may differ by up to the detector's tolerance
+	Legs    []Leg
+	Margin  float64   // 1 - S, the guaranteed profit as a fraction of total stake
+	Stakes  []float64 // stake for each entry in Legs, summing to the detector's total stake
+	Middle  bool      // true if Legs carry different lines within tolerance (a "middle", not a pure arb)
+}
@@ -0,0 +1,9 @@
+package arbitrage
+
+// Sink is a downstream consumer of detected opportunities: a log, a webhook, a
+// database, or anything else that wants to subscribe. This is the output port in
+// a hexagonal sense — Feed only depends on this interface, never on logging,
+// HTTP, or SQL directly.
+type Sink interface {
+	Publish(o Opportunity) error
+}
@@ -0,0 +1,40 @@
+package arbitrage
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// WebhookSink POSTs each opportunity as JSON to a configured URL.
+type WebhookSink struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewWebhookSink builds a WebhookSink that posts to url using http.DefaultClient.
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{URL: url, Client: http.DefaultClient}
+}
+
+// Publish POSTs o as JSON to the sink's URL, returning an error on a non-2xx
+// response or transport failure.
+func (w *WebhookSink) Publish(o Opportunity) error {
+	body, err := json.Marshal(o)
+	if err != nil {
+		return fmt.Errorf("arbitrage: marshaling opportunity: %w", err)
+	}
+
+	resp, err := w.Client.Post(w.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("arbitrage: posting to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("arbitrage: webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
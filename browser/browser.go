@@ -0,0 +1,246 @@
+// Package browser wraps chromedp with the hardening DraftKings-style
+// fingerprinting needs: rotating User-Agents, rotating proxies with failure
+// backoff, the standard stealth patches, and a browser process reused across
+// ticks instead of relaunched every scrape.
+package browser
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/chromedp/cdproto/emulation"
+	"github.com/chromedp/chromedp"
+)
+
+// Config configures a Browser's rotation and failure-handling behavior.
+type Config struct {
+	UserAgents   []string
+	Proxies      []ProxyConfig
+	ProxyBackoff time.Duration // defaults to 5 minutes if zero
+}
+
+// generation is one launched Chrome process. Navigate calls derive their tab
+// context from browserCtx and hold a reference for as long as they're
+// in-flight, so relaunch can retire a generation without tearing down a
+// browser context that another goroutine is still navigating against.
+type generation struct {
+	browserCtx    context.Context
+	browserCancel context.CancelFunc
+	allocCancel   context.CancelFunc
+	proxy         string
+
+	mu          sync.Mutex
+	refCount    int
+	retired     bool
+	relaunching bool
+}
+
+// claimRelaunch reports whether the caller is the first to relaunch this
+// generation, so two Navigate calls failing against the same generation at
+// once don't each spawn a fresh Chrome process.
+func (g *generation) claimRelaunch() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.relaunching {
+		return false
+	}
+	g.relaunching = true
+	return true
+}
+
+func (g *generation) acquire() {
+	g.mu.Lock()
+	g.refCount++
+	g.mu.Unlock()
+}
+
+func (g *generation) release() {
+	g.mu.Lock()
+	g.refCount--
+	shouldClose := g.retired && g.refCount == 0
+	g.mu.Unlock()
+
+	if shouldClose {
+		g.browserCancel()
+		g.allocCancel()
+	}
+}
+
+// retire marks g for teardown once every in-flight Navigate against it has
+// released, tearing it down immediately if none are outstanding.
+func (g *generation) retire() {
+	g.mu.Lock()
+	g.retired = true
+	shouldClose := g.refCount == 0
+	g.mu.Unlock()
+
+	if shouldClose {
+		g.browserCancel()
+		g.allocCancel()
+	}
+}
+
+// Browser is a persistent headless Chrome instance that serves Navigate calls
+// for every scrape tick, each in its own tab so pages don't accumulate state.
+// Chrome is relaunched in place when a proxy fails; Navigate holds a
+// reference to the generation it started against so a relaunch triggered by
+// one book never tears down a context another book is still navigating.
+type Browser struct {
+	uas     *UserAgentPool
+	proxies *ProxyPool
+	backoff time.Duration
+
+	mu  sync.Mutex
+	gen *generation
+}
+
+// New launches a persistent headless Chrome instance using the first
+// available proxy (if any) and returns a Browser ready to serve Navigate calls.
+func New(cfg Config) (*Browser, error) {
+	backoff := cfg.ProxyBackoff
+	if backoff == 0 {
+		backoff = 5 * time.Minute
+	}
+
+	b := &Browser{
+		uas:     NewUserAgentPool(cfg.UserAgents),
+		proxies: NewProxyPool(cfg.Proxies, backoff),
+		backoff: backoff,
+	}
+
+	if err := b.launch(); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func (b *Browser) launch() error {
+	proxy := b.proxies.Next()
+
+	opts := append(chromedp.DefaultExecAllocatorOptions[:], chromedp.Flag("headless", true))
+	if proxy != "" {
+		opts = append(opts, chromedp.ProxyServer(proxy))
+	}
+
+	allocCtx, allocCancel := chromedp.NewExecAllocator(context.Background(), opts...)
+	browserCtx, browserCancel := chromedp.NewContext(allocCtx)
+
+	// Start the browser process now, rather than lazily on first Navigate, so
+	// launch failures surface immediately.
+	if err := chromedp.Run(browserCtx); err != nil {
+		browserCancel()
+		allocCancel()
+		return fmt.Errorf("browser: launching chrome: %w", err)
+	}
+
+	gen := &generation{
+		browserCtx:    browserCtx,
+		browserCancel: browserCancel,
+		allocCancel:   allocCancel,
+		proxy:         proxy,
+	}
+
+	b.mu.Lock()
+	b.gen = gen
+	b.mu.Unlock()
+
+	log.Printf("browser: launched chrome (proxy=%s)\n", proxyLabel(proxy))
+	return nil
+}
+
+// Navigate opens url in a fresh tab of the persistent browser, waits for
+// ready to become visible, and returns the page's outer HTML. The tab gets
+// the next User-Agent in rotation, the browser's current proxy, and the
+// standard stealth patches. ctx bounds how long the navigation is allowed to run.
+func (b *Browser) Navigate(ctx context.Context, url, ready string) (string, error) {
+	b.mu.Lock()
+	gen := b.gen
+	b.mu.Unlock()
+
+	gen.acquire()
+	defer gen.release()
+	proxy := gen.proxy
+
+	ua := b.uas.Next()
+	log.Printf("browser: navigating %s (ua=%q proxy=%s)\n", url, ua, proxyLabel(proxy))
+
+	tabCtx, cancel := chromedp.NewContext(gen.browserCtx)
+	defer cancel()
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			cancel()
+		case <-stop:
+		}
+	}()
+
+	var html string
+	err := chromedp.Run(tabCtx,
+		stealthAction(),
+		emulation.SetUserAgentOverride(ua).WithAcceptLanguage("en-US,en"),
+		chromedp.Navigate(url),
+		chromedp.WaitVisible(ready, chromedp.ByQuery),
+		chromedp.OuterHTML("html", &html),
+	)
+	if err != nil {
+		b.proxies.ReportFailure(proxy)
+		if proxy != "" {
+			if relaunchErr := b.relaunch(gen); relaunchErr != nil {
+				log.Printf("browser: relaunching after proxy failure: %v\n", relaunchErr)
+			}
+		}
+		return "", fmt.Errorf("browser: navigating: %w", err)
+	}
+
+	return html, nil
+}
+
+// relaunch starts a fresh Chrome process on the next available proxy and
+// retires failed, so a failed proxy is actually rotated away from rather
+// than just marked banned for a pool that nothing rereads. failed is only
+// torn down once every Navigate still reading from it (including, possibly,
+// other books' in-flight tabs) has released it, so one book's proxy hiccup
+// never cancels a context another book is concurrently navigating against.
+// If failed has already been superseded by a concurrent relaunch, this is a
+// no-op: that relaunch already rotated the proxy for everyone.
+func (b *Browser) relaunch(failed *generation) error {
+	if !failed.claimRelaunch() {
+		return nil
+	}
+
+	if err := b.launch(); err != nil {
+		return err
+	}
+
+	failed.retire()
+	return nil
+}
+
+// Close shuts down the persistent browser and releases its resources.
+func (b *Browser) Close() {
+	b.mu.Lock()
+	gen := b.gen
+	b.mu.Unlock()
+	gen.retire()
+}
+
+// proxyLabel formats proxy for logging with any embedded credentials redacted.
+func proxyLabel(proxy string) string {
+	if proxy == "" {
+		return "none"
+	}
+	u, err := url.Parse(proxy)
+	if err != nil || u.User == nil {
+		return proxy
+	}
+	u.User = url.UserPassword("***", "***")
+	return u.String()
+}
@@ -0,0 +1,31 @@
+package browser
+
+import (
+	"context"
+
+	"github.com/chromedp/cdproto/page"
+	"github.com/chromedp/chromedp"
+)
+
+// stealthScript patches the handful of navigator/WebGL fingerprints headless
+// Chrome otherwise exposes, following the standard chromedp-stealth recipe.
+const stealthScript = `
+Object.defineProperty(navigator, 'webdriver', { get: () => undefined });
+Object.defineProperty(navigator, 'plugins', { get: () => [1, 2, 3, 4, 5] });
+Object.defineProperty(navigator, 'languages', { get: () => ['en-US', 'en'] });
+const getParameter = WebGLRenderingContext.prototype.getParameter;
+WebGLRenderingContext.prototype.getParameter = function (parameter) {
+	if (parameter === 37445) return 'Intel Inc.';
+	if (parameter === 37446) return 'Intel Iris OpenGL Engine';
+	return getParameter.call(this, parameter);
+};
+`
+
+// stealthAction registers stealthScript to run before every page load in the
+// tab it's run against.
+func stealthAction() chromedp.Action {
+	return chromedp.ActionFunc(func(ctx context.Context) error {
+		_, err := page.AddScriptToEvaluateOnNewDocument(stealthScript).Do(ctx)
+		return err
+	})
+}
@@ -0,0 +1,33 @@
+package browser
+
+import "sync"
+
+// defaultUserAgent is used when a Config supplies no User-Agent pool.
+const defaultUserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36"
+
+// UserAgentPool rotates through a configurable set of User-Agent strings so
+// requests don't all present the same fingerprint.
+type UserAgentPool struct {
+	mu     sync.Mutex
+	agents []string
+	next   int
+}
+
+// NewUserAgentPool builds a pool that rotates through agents in order,
+// wrapping around. Falls back to a single modern Chrome UA if agents is empty.
+func NewUserAgentPool(agents []string) *UserAgentPool {
+	if len(agents) == 0 {
+		agents = []string{defaultUserAgent}
+	}
+	return &UserAgentPool{agents: agents}
+}
+
+// Next returns the next User-Agent in rotation.
+func (p *UserAgentPool) Next() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ua := p.agents[p.next]
+	p.next = (p.next + 1) % len(p.agents)
+	return ua
+}
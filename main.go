@@ -2,192 +2,151 @@ package main
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"log"
-	"strconv"
-	"strings"
+	"net/http"
+	"os"
 	"time"
 
-	"github.com/PuerkitoBio/goquery"
-	"github.com/chromedp/chromedp"
+	"github.com/henrriusdev/scrapport/alert"
+	"github.com/henrriusdev/scrapport/arbitrage"
+	"github.com/henrriusdev/scrapport/scraper"
+	"github.com/henrriusdev/scrapport/server"
+	"github.com/henrriusdev/scrapport/storage"
+	"github.com/henrriusdev/scrapport/ui"
 )
 
-// Market represents a betting market with game info, bet type, odds, and line
-type Market struct {
-	Game    string  // Game description (e.g., "Team A vs Team B")
-	Side    string  // Side of the bet (e.g., "over", "under")
-	Odds    float64 // American odds
-	Line    float64 // Point spread or total line
-	BetType string  // Type of bet ("Moneyline", "Total", "Spread")
-}
-
 const (
 	ScrapeInterval = 30 * time.Second
-	PageTimeout    = 2 * time.Minute
-	NFLURL         = "https://sportsbook.draftkings.com/leagues/football/nfl"
+	ConfigPath     = "config/books.yaml"
+	AlertsPath     = "config/alerts.yaml"
+	LineTolerance  = 0.5
+	TotalStake     = 100.0
+	DBPath         = "scrapport.db"
+	LogPath        = "scrapport.log"
+	ServerAddr     = ":8080"
+	TokenTTL       = 24 * time.Hour
 )
 
-var betTypes = []string{"Spread", "Total", "Moneyline"}
-
 func main() {
-	log.Println("Starting DraftKings NFL scraper...")
-	log.Printf("Scraping every %v\n", ScrapeInterval)
-
-	// Run immediately on start
-	scrapeAndLog()
-
-	// Then run continuously
-	ticker := time.NewTicker(ScrapeInterval)
-	defer ticker.Stop()
-
-	for range ticker.C {
-		scrapeAndLog()
+	logFile, err := os.OpenFile(LogPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		log.Fatalf("opening log file: %v", err)
 	}
-}
-
-func scrapeAndLog() {
-	log.Println("\n=== Starting scrape ===")
+	defer logFile.Close()
+	log.SetOutput(logFile) // the dashboard owns the terminal now; logs go to a file instead
 
-	markets, err := scrapeNFLMarkets()
+	cfg, err := scraper.LoadConfig(ConfigPath)
 	if err != nil {
-		log.Printf("Error scraping markets: %v\n", err)
-		return
+		log.Fatalf("loading config: %v", err)
 	}
 
-	printMarkets(markets)
-}
-
-func scrapeNFLMarkets() ([]Market, error) {
-	ctx, cancel := chromedp.NewContext(context.Background())
-	defer cancel()
-
-	ctx, cancel = context.WithTimeout(ctx, PageTimeout)
-	defer cancel()
-
-	var htmlContent string
-
-	log.Println("Loading page with headless Chrome...")
-	err := chromedp.Run(ctx,
-		chromedp.Navigate(NFLURL),
-		chromedp.WaitVisible(`.cms-market-selector-content`, chromedp.ByQuery),
-		chromedp.OuterHTML(`html`, &htmlContent),
-	)
+	orch, err := scraper.NewOrchestrator(cfg)
 	if err != nil {
-		return nil, fmt.Errorf("chromedp error: %w", err)
+		log.Fatalf("building orchestrator: %v", err)
 	}
+	defer orch.Close()
 
-	log.Println("Parsing markets...")
-	return parseMarkets(htmlContent), nil
-}
+	feed := arbitrage.NewFeed(arbitrage.NewDetector(LineTolerance, TotalStake), arbitrage.LogSink{})
 
-func parseMarkets(html string) []Market {
-	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	store, err := storage.NewSQLiteStore(DBPath)
 	if err != nil {
-		log.Printf("Error parsing HTML: %v\n", err)
-		return nil
+		log.Fatalf("opening store: %v", err)
 	}
+	defer store.Close()
 
-	var markets []Market
-
-	doc.Find(".cb-market__template").Each(func(i int, gameWrapper *goquery.Selection) {
-		var teamA, teamB string
-
-		// Extract team names
-		gameWrapper.Find(".cb-market__label-inner").Each(func(j int, teamSel *goquery.Selection) {
-			switch j {
-			case 0:
-				teamA = strings.TrimSpace(teamSel.Text())
-			case 1:
-				teamB = strings.TrimSpace(teamSel.Text())
-			}
-		})
+	evaluator := loadEvaluator(AlertsPath)
 
-		if teamA == "" || teamB == "" {
-			return
+	srv := server.NewServer(store, server.NewAuthenticator(jwtSecret(), apiKey(), TokenTTL))
+	go func() {
+		if err := http.ListenAndServe(ServerAddr, srv.Handler()); err != nil {
+			log.Fatalf("serving API: %v", err)
 		}
+	}()
 
-		gameDescription := fmt.Sprintf("%s vs %s", teamA, teamB)
+	dash, err := ui.NewDashboard()
+	if err != nil {
+		log.Fatalf("starting dashboard: %v", err)
+	}
+	defer dash.Close()
 
-		// Extract market data from buttons
-		gameWrapper.Find(".cb-market__button").Each(func(j int, button *goquery.Selection) {
-			lineText := button.Find(".cb-market__button-points").Text()
-			oddsText := button.Find(".cb-market__button-odds").Text()
-			betType := betTypes[j%3]
+	go dash.Run(context.Background())
 
-			line := parseOdds(lineText)
-			odds := parseOdds(oddsText)
+	tick(orch, feed, store, evaluator, srv, dash)
 
-			// Determine side (first 3 are one side, next 3 are the other)
-			side := "over"
-			if j >= 3 {
-				side = "under"
-			}
+	ticker := time.NewTicker(ScrapeInterval)
+	defer ticker.Stop()
 
-			market := Market{
-				Game:    gameDescription,
-				Side:    side,
-				Odds:    odds,
-				Line:    line,
-				BetType: betType,
+	for {
+		select {
+		case <-dash.Done():
+			return
+		case <-ticker.C:
+			if dash.Paused() {
+				continue
 			}
-			markets = append(markets, market)
-		})
-	})
+			tick(orch, feed, store, evaluator, srv, dash)
+		}
+	}
+}
 
-	return markets
+// jwtSecret reads the API's signing secret from SCRAPPORT_JWT_SECRET, falling
+// back to a fixed development secret with a warning.
+func jwtSecret() []byte {
+	if secret := os.Getenv("SCRAPPORT_JWT_SECRET"); secret != "" {
+		return []byte(secret)
+	}
+	log.Println("SCRAPPORT_JWT_SECRET not set; using an insecure development secret")
+	return []byte("dev-secret-do-not-use-in-production")
 }
 
-func parseOdds(oddsStr string) float64 {
-	oddsStr = strings.TrimSpace(oddsStr)
-	if oddsStr == "" {
-		return 0.0
+// apiKey reads the key clients must present to POST /token from
+// SCRAPPORT_API_KEY, falling back to a fixed development key with a warning.
+func apiKey() []byte {
+	if key := os.Getenv("SCRAPPORT_API_KEY"); key != "" {
+		return []byte(key)
 	}
+	log.Println("SCRAPPORT_API_KEY not set; using an insecure development key")
+	return []byte("dev-key-do-not-use-in-production")
+}
 
-	// Handle both regular minus (-) and unicode minus (−)
-	isMinus := strings.HasPrefix(oddsStr, "-") || strings.HasPrefix(oddsStr, "−")
-	oddsStr = strings.TrimPrefix(oddsStr, "+")
-	oddsStr = strings.TrimPrefix(oddsStr, "-")
-	oddsStr = strings.TrimPrefix(oddsStr, "−")
+// loadEvaluator loads the alert rules at path, notifying via desktop
+// notification. A missing rules file just means alerting is disabled.
+func loadEvaluator(path string) *alert.Evaluator {
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
 
-	val, err := strconv.ParseFloat(oddsStr, 64)
+	rules, err := alert.LoadRules(path)
 	if err != nil {
-		return 0.0
+		log.Fatalf("loading alert rules: %v", err)
 	}
 
-	if isMinus {
-		return -val
-	}
-	return val
+	return alert.NewEvaluator(rules, alert.DesktopTransport{})
 }
 
-func printMarkets(markets []Market) {
-	if len(markets) == 0 {
-		log.Println("No markets found")
-		return
+func tick(orch *scraper.Orchestrator, feed *arbitrage.Feed, store storage.Store, evaluator *alert.Evaluator, srv *server.Server, dash *ui.Dashboard) {
+	start := time.Now()
+	markets := orch.Run(context.Background())
+	latency := time.Since(start)
+
+	opportunities := feed.Process(markets)
+	saveMarkets(store, markets)
+	if evaluator != nil {
+		evaluator.Evaluate(markets)
 	}
 
-	fmt.Printf("\n=== Found %d Markets ===\n\n", len(markets))
+	srv.PushMarkets(markets)
+	srv.PushOpportunities(opportunities)
 
-	// Group markets by game
-	gameMap := make(map[string][]Market)
-	for _, m := range markets {
-		gameMap[m.Game] = append(gameMap[m.Game], m)
-	}
+	dash.Update(markets, latency, time.Now().Add(ScrapeInterval))
+}
 
-	// Print each game's markets
-	for game, gameMarkets := range gameMap {
-		fmt.Printf("%s\n", game)
-		fmt.Println(strings.Repeat("-", len(game)))
-
-		for _, m := range gameMarkets {
-			if m.Line != 0 {
-				fmt.Printf("  %-10s | %-8s | Line: %6.1f | Odds: %+6.0f\n",
-					m.BetType, m.Side, m.Line, m.Odds)
-			} else {
-				fmt.Printf("  %-10s | %-8s | Odds: %+6.0f\n",
-					m.BetType, m.Side, m.Odds)
-			}
+func saveMarkets(store storage.Store, markets []scraper.Market) {
+	observedAt := time.Now()
+	for _, m := range markets {
+		if err := store.SaveMarket(context.Background(), m, observedAt); err != nil {
+			log.Printf("storage: %v\n", err)
 		}
-		fmt.Println()
 	}
 }
@@ -0,0 +1,46 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/henrriusdev/scrapport/browser"
+)
+
+func init() {
+	Register("betmgm", newBetMGMScraper)
+}
+
+// betMGMDefaults are the CSS selectors for BetMGM's current NFL page layout.
+var betMGMDefaults = Selectors{
+	Ready:        ".grid-event-wrapper",
+	GameWrapper:  ".grid-event-wrapper",
+	TeamLabel:    ".participant-name",
+	MarketButton: ".option-indicator",
+	Points:       ".option-indicator .d-block",
+	Odds:         ".option-indicator .option-price",
+}
+
+// BetMGMScraper scrapes NFL markets from sports.betmgm.com via headless Chrome.
+type BetMGMScraper struct {
+	cfg BookConfig
+	sel Selectors
+	br  *browser.Browser
+}
+
+func newBetMGMScraper(cfg BookConfig, br *browser.Browser) Scraper {
+	return &BetMGMScraper{cfg: cfg, sel: mergeSelectors(cfg.Selectors, betMGMDefaults), br: br}
+}
+
+// Book returns the adapter's registry name.
+func (s *BetMGMScraper) Book() string { return "betmgm" }
+
+// Scrape loads the BetMGM NFL page and parses its markets.
+func (s *BetMGMScraper) Scrape(ctx context.Context) ([]Market, error) {
+	html, err := s.br.Navigate(ctx, s.cfg.URL, s.sel.Ready)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.Book(), err)
+	}
+
+	return ParseMarkets(html, s.Book(), s.sel), nil
+}
@@ -0,0 +1,111 @@
+package scraper
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/henrriusdev/scrapport/browser"
+)
+
+// Selectors holds the CSS selectors an adapter needs to locate markets on a book's
+// page. Zero-value fields fall back to the adapter's built-in defaults, so a config
+// only needs to override the selectors that differ from the default layout.
+type Selectors struct {
+	Ready        string `yaml:"ready"` // selector chromedp waits on before scraping
+	GameWrapper  string `yaml:"game_wrapper"`
+	TeamLabel    string `yaml:"team_label"`
+	MarketButton string `yaml:"market_button"`
+	Points       string `yaml:"points"`
+	Odds         string `yaml:"odds"`
+}
+
+// BookConfig describes one enabled sportsbook: where to scrape it, how patient to be,
+// and which selectors to use if the adapter's defaults don't match.
+type BookConfig struct {
+	Book      string        `yaml:"book"`
+	URL       string        `yaml:"url"`
+	Timeout   time.Duration `yaml:"timeout"`
+	Retries   int           `yaml:"retries"`
+	Selectors Selectors     `yaml:"selectors"`
+}
+
+// Config is the top-level scraper configuration: one entry per enabled book,
+// plus the shared browser's anti-bot rotation settings.
+type Config struct {
+	Books   []BookConfig  `yaml:"books"`
+	Browser BrowserConfig `yaml:"browser"`
+}
+
+// BrowserConfig configures the persistent headless Chrome instance shared by
+// every adapter. An empty config is valid: no proxy, one built-in User-Agent.
+type BrowserConfig struct {
+	UserAgents   []string      `yaml:"user_agents"`
+	Proxies      []string      `yaml:"proxies"`
+	ProxyBackoff time.Duration `yaml:"proxy_backoff"`
+}
+
+// toBrowserConfig converts BrowserConfig into the browser package's own Config.
+func (c BrowserConfig) toBrowserConfig() browser.Config {
+	proxies := make([]browser.ProxyConfig, len(c.Proxies))
+	for i, p := range c.Proxies {
+		proxies[i] = browser.ProxyConfig{URL: p}
+	}
+
+	return browser.Config{
+		UserAgents:   c.UserAgents,
+		Proxies:      proxies,
+		ProxyBackoff: c.ProxyBackoff,
+	}
+}
+
+// LoadConfig reads and parses a books config file, filling in defaults for any
+// timeout/retries left unset.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("scraper: reading config: %w", err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("scraper: parsing config: %w", err)
+	}
+
+	for i := range cfg.Books {
+		if cfg.Books[i].Timeout == 0 {
+			cfg.Books[i].Timeout = DefaultTimeout
+		}
+		if cfg.Books[i].Retries == 0 {
+			cfg.Books[i].Retries = DefaultRetries
+		}
+	}
+
+	return cfg, nil
+}
+
+// mergeSelectors fills in any field left blank in override with the corresponding
+// field from defaults.
+func mergeSelectors(override, defaults Selectors) Selectors {
+	if override.Ready == "" {
+		override.Ready = defaults.Ready
+	}
+	if override.GameWrapper == "" {
+		override.GameWrapper = defaults.GameWrapper
+	}
+	if override.TeamLabel == "" {
+		override.TeamLabel = defaults.TeamLabel
+	}
+	if override.MarketButton == "" {
+		override.MarketButton = defaults.MarketButton
+	}
+	if override.Points == "" {
+		override.Points = defaults.Points
+	}
+	if override.Odds == "" {
+		override.Odds = defaults.Odds
+	}
+	return override
+}
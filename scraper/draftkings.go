@@ -0,0 +1,46 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/henrriusdev/scrapport/browser"
+)
+
+func init() {
+	Register("draftkings", newDraftKingsScraper)
+}
+
+// draftKingsDefaults are the CSS selectors for DraftKings' current NFL page layout.
+var draftKingsDefaults = Selectors{
+	Ready:        ".cms-market-selector-content",
+	GameWrapper:  ".cb-market__template",
+	TeamLabel:    ".cb-market__label-inner",
+	MarketButton: ".cb-market__button",
+	Points:       ".cb-market__button-points",
+	Odds:         ".cb-market__button-odds",
+}
+
+// DraftKingsScraper scrapes NFL markets from sportsbook.draftkings.com via headless Chrome.
+type DraftKingsScraper struct {
+	cfg BookConfig
+	sel Selectors
+	br  *browser.Browser
+}
+
+func newDraftKingsScraper(cfg BookConfig, br *browser.Browser) Scraper {
+	return &DraftKingsScraper{cfg: cfg, sel: mergeSelectors(cfg.Selectors, draftKingsDefaults), br: br}
+}
+
+// Book returns the adapter's registry name.
+func (s *DraftKingsScraper) Book() string { return "draftkings" }
+
+// Scrape loads the DraftKings NFL page and parses its markets.
+func (s *DraftKingsScraper) Scrape(ctx context.Context) ([]Market, error) {
+	html, err := s.br.Navigate(ctx, s.cfg.URL, s.sel.Ready)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.Book(), err)
+	}
+
+	return ParseMarkets(html, s.Book(), s.sel), nil
+}
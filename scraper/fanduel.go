@@ -0,0 +1,46 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/henrriusdev/scrapport/browser"
+)
+
+func init() {
+	Register("fanduel", newFanDuelScraper)
+}
+
+// fanDuelDefaults are the CSS selectors for FanDuel's current NFL page layout.
+var fanDuelDefaults = Selectors{
+	Ready:        "[data-test-id='Events']",
+	GameWrapper:  "[data-test-id='EventCard']",
+	TeamLabel:    "[data-test-id='competitor-name']",
+	MarketButton: "[data-test-id='Outcome']",
+	Points:       "[data-test-id='Outcome-spread']",
+	Odds:         "[data-test-id='Outcome-odds']",
+}
+
+// FanDuelScraper scrapes NFL markets from sportsbook.fanduel.com via headless Chrome.
+type FanDuelScraper struct {
+	cfg BookConfig
+	sel Selectors
+	br  *browser.Browser
+}
+
+func newFanDuelScraper(cfg BookConfig, br *browser.Browser) Scraper {
+	return &FanDuelScraper{cfg: cfg, sel: mergeSelectors(cfg.Selectors, fanDuelDefaults), br: br}
+}
+
+// Book returns the adapter's registry name.
+func (s *FanDuelScraper) Book() string { return "fanduel" }
+
+// Scrape loads the FanDuel NFL page and parses its markets.
+func (s *FanDuelScraper) Scrape(ctx context.Context) ([]Market, error) {
+	html, err := s.br.Navigate(ctx, s.cfg.URL, s.sel.Ready)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.Book(), err)
+	}
+
+	return ParseMarkets(html, s.Book(), s.sel), nil
+}
@@ -0,0 +1,14 @@
+package scraper
+
+// Market represents a betting market with game info, bet type, odds, and line.
+type Market struct {
+	Book    string  // Sportsbook that produced this market (e.g. "draftkings")
+	Game    string  // Game description (e.g., "Team A vs Team B")
+	Side    string  // Side of the bet (e.g., "over", "under")
+	Odds    float64 // American odds
+	Line    float64 // Point spread or total line
+	BetType string  // Type of bet ("Moneyline", "Total", "Spread")
+}
+
+// BetTypes is the fixed rotation of bet types each book's market buttons cycle through.
+var BetTypes = []string{"Spread", "Total", "Moneyline"}
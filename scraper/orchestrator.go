@@ -0,0 +1,105 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/henrriusdev/scrapport/browser"
+)
+
+const (
+	DefaultTimeout = 2 * time.Minute
+	DefaultRetries = 2
+)
+
+// Orchestrator fans scrapes for every enabled book out concurrently and aggregates
+// the results into a single []Market stream tagged by book.
+type Orchestrator struct {
+	browser  *browser.Browser
+	scrapers []Scraper
+	configs  map[string]BookConfig
+}
+
+// NewOrchestrator builds an Orchestrator for the books in cfg, launching the shared
+// browser and resolving each book against the adapter registry.
+func NewOrchestrator(cfg Config) (*Orchestrator, error) {
+	br, err := browser.New(cfg.Browser.toBrowserConfig())
+	if err != nil {
+		return nil, fmt.Errorf("scraper: launching browser: %w", err)
+	}
+
+	o := &Orchestrator{browser: br, configs: make(map[string]BookConfig, len(cfg.Books))}
+
+	for _, bc := range cfg.Books {
+		s, err := Build(bc, br)
+		if err != nil {
+			br.Close()
+			return nil, err
+		}
+		o.scrapers = append(o.scrapers, s)
+		o.configs[bc.Book] = bc
+	}
+
+	return o, nil
+}
+
+// Close shuts down the orchestrator's shared browser.
+func (o *Orchestrator) Close() {
+	o.browser.Close()
+}
+
+// Run scrapes every enabled book concurrently, retrying each according to its own
+// config, and returns the combined markets. A book that still fails after all
+// retries is logged and excluded rather than failing the whole run.
+func (o *Orchestrator) Run(ctx context.Context) []Market {
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		markets []Market
+	)
+
+	for _, s := range o.scrapers {
+		s := s
+		cfg := o.configs[s.Book()]
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			found, err := scrapeWithRetry(ctx, s, cfg)
+			if err != nil {
+				log.Printf("%s: %v\n", s.Book(), err)
+				return
+			}
+
+			mu.Lock()
+			markets = append(markets, found...)
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+	return markets
+}
+
+func scrapeWithRetry(ctx context.Context, s Scraper, cfg BookConfig) ([]Market, error) {
+	var lastErr error
+
+	for attempt := 0; attempt <= cfg.Retries; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+		markets, err := s.Scrape(attemptCtx)
+		cancel()
+
+		if err == nil {
+			return markets, nil
+		}
+
+		lastErr = err
+		log.Printf("%s: attempt %d/%d failed: %v\n", s.Book(), attempt+1, cfg.Retries+1, err)
+	}
+
+	return nil, fmt.Errorf("all %d attempts failed: %w", cfg.Retries+1, lastErr)
+}
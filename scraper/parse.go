@@ -0,0 +1,92 @@
+package scraper
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// ParseMarkets extracts markets from a scraped page's HTML using sel to locate them,
+// tagging every result with book. Adapters share this since books in this registry
+// all lay their market grids out the same way (a wrapper per game, a button per
+// side/bet-type cell), differing only in class names.
+func ParseMarkets(html string, book string, sel Selectors) []Market {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		log.Printf("%s: error parsing HTML: %v\n", book, err)
+		return nil
+	}
+
+	var markets []Market
+
+	doc.Find(sel.GameWrapper).Each(func(i int, gameWrapper *goquery.Selection) {
+		var teamA, teamB string
+
+		gameWrapper.Find(sel.TeamLabel).Each(func(j int, teamSel *goquery.Selection) {
+			switch j {
+			case 0:
+				teamA = strings.TrimSpace(teamSel.Text())
+			case 1:
+				teamB = strings.TrimSpace(teamSel.Text())
+			}
+		})
+
+		if teamA == "" || teamB == "" {
+			return
+		}
+
+		gameDescription := fmt.Sprintf("%s vs %s", teamA, teamB)
+
+		gameWrapper.Find(sel.MarketButton).Each(func(j int, button *goquery.Selection) {
+			lineText := button.Find(sel.Points).Text()
+			oddsText := button.Find(sel.Odds).Text()
+			betType := BetTypes[j%3]
+
+			line := parseOdds(lineText)
+			odds := parseOdds(oddsText)
+
+			// Determine side (first 3 are one side, next 3 are the other)
+			side := "over"
+			if j >= 3 {
+				side = "under"
+			}
+
+			markets = append(markets, Market{
+				Book:    book,
+				Game:    gameDescription,
+				Side:    side,
+				Odds:    odds,
+				Line:    line,
+				BetType: betType,
+			})
+		})
+	})
+
+	return markets
+}
+
+func parseOdds(oddsStr string) float64 {
+	oddsStr = strings.TrimSpace(oddsStr)
+	if oddsStr == "" {
+		return 0.0
+	}
+
+	// Handle both regular minus (-) and unicode minus (−)
+	isMinus := strings.HasPrefix(oddsStr, "-") || strings.HasPrefix(oddsStr, "−")
+	oddsStr = strings.TrimPrefix(oddsStr, "+")
+	oddsStr = strings.TrimPrefix(oddsStr, "-")
+	oddsStr = strings.TrimPrefix(oddsStr, "−")
+
+	val, err := strconv.ParseFloat(oddsStr, 64)
+	if err != nil {
+		return 0.0
+	}
+
+	if isMinus {
+		return -val
+	}
+	return val
+}
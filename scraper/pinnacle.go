@@ -0,0 +1,46 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/henrriusdev/scrapport/browser"
+)
+
+func init() {
+	Register("pinnacle", newPinnacleScraper)
+}
+
+// pinnacleDefaults are the CSS selectors for Pinnacle's current NFL page layout.
+var pinnacleDefaults = Selectors{
+	Ready:        ".style_matchup__",
+	GameWrapper:  ".style_matchup__",
+	TeamLabel:    ".style_participantName__",
+	MarketButton: ".style_priceButton__",
+	Points:       ".style_points__",
+	Odds:         ".style_price__",
+}
+
+// PinnacleScraper scrapes NFL markets from pinnacle.com via headless Chrome.
+type PinnacleScraper struct {
+	cfg BookConfig
+	sel Selectors
+	br  *browser.Browser
+}
+
+func newPinnacleScraper(cfg BookConfig, br *browser.Browser) Scraper {
+	return &PinnacleScraper{cfg: cfg, sel: mergeSelectors(cfg.Selectors, pinnacleDefaults), br: br}
+}
+
+// Book returns the adapter's registry name.
+func (s *PinnacleScraper) Book() string { return "pinnacle" }
+
+// Scrape loads the Pinnacle NFL page and parses its markets.
+func (s *PinnacleScraper) Scrape(ctx context.Context) ([]Market, error) {
+	html, err := s.br.Navigate(ctx, s.cfg.URL, s.sel.Ready)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.Book(), err)
+	}
+
+	return ParseMarkets(html, s.Book(), s.sel), nil
+}
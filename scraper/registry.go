@@ -0,0 +1,25 @@
+package scraper
+
+import (
+	"fmt"
+
+	"github.com/henrriusdev/scrapport/browser"
+)
+
+var factories = map[string]Factory{}
+
+// Register adds a Scraper factory under name so it can be enabled via config.
+// Adapters call this from an init func.
+func Register(name string, factory Factory) {
+	factories[name] = factory
+}
+
+// Build constructs the Scraper registered under cfg.Book, wired to share br for
+// loading pages.
+func Build(cfg BookConfig, br *browser.Browser) (Scraper, error) {
+	factory, ok := factories[cfg.Book]
+	if !ok {
+		return nil, fmt.Errorf("scraper: no adapter registered for book %q", cfg.Book)
+	}
+	return factory(cfg, br), nil
+}
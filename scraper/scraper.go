@@ -0,0 +1,20 @@
+package scraper
+
+import (
+	"context"
+
+	"github.com/henrriusdev/scrapport/browser"
+)
+
+// Scraper fetches the current markets for a single sportsbook.
+type Scraper interface {
+	// Book returns the stable identifier used to tag markets and look up config (e.g. "draftkings").
+	Book() string
+	// Scrape loads the book's page and returns the markets found on it.
+	Scrape(ctx context.Context) ([]Market, error)
+}
+
+// Factory builds a Scraper from its resolved BookConfig and the shared Browser it
+// should use to load pages. Adapters register one under their book name so the
+// orchestrator can build scrapers purely from config.
+type Factory func(cfg BookConfig, br *browser.Browser) Scraper
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/henrriusdev/scrapport/arbitrage"
+)
+
+// handleArbs serves GET /arbs?minMargin=, returning the latest detected
+// opportunities at or above the given margin (default: all of them).
+func (s *Server) handleArbs(w http.ResponseWriter, r *http.Request) {
+	minMargin := 0.0
+	if raw := r.URL.Query().Get("minMargin"); raw != "" {
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			http.Error(w, "minMargin must be a number", http.StatusBadRequest)
+			return
+		}
+		minMargin = parsed
+	}
+
+	s.mu.RLock()
+	opportunities := make([]arbitrage.Opportunity, 0, len(s.opportunities))
+	for _, o := range s.opportunities {
+		if o.Margin >= minMargin {
+			opportunities = append(opportunities, o)
+		}
+	}
+	s.mu.RUnlock()
+
+	writeJSON(w, opportunities)
+}
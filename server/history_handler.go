@@ -0,0 +1,39 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// handleHistory serves GET /history/{game}/{betType}?since=&side=, returning
+// the line/odds time series for that market from the Store. since defaults to
+// 24 hours ago and side defaults to "over" when not given.
+func (s *Server) handleHistory(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	game, betType := vars["game"], vars["betType"]
+
+	side := r.URL.Query().Get("side")
+	if side == "" {
+		side = "over"
+	}
+
+	since := time.Now().Add(-24 * time.Hour)
+	if raw := r.URL.Query().Get("since"); raw != "" {
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			http.Error(w, "since must be RFC3339", http.StatusBadRequest)
+			return
+		}
+		since = parsed
+	}
+
+	points, err := s.store.LineHistory(r.Context(), game, betType, side, since)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, points)
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"sync"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/henrriusdev/scrapport/arbitrage"
+	"github.com/henrriusdev/scrapport/scraper"
+)
+
+// StreamMessage is one event pushed to /stream subscribers.
+type StreamMessage struct {
+	Type          string                  `json:"type"` // "markets" or "arbitrage"
+	Markets       []scraper.Market        `json:"markets,omitempty"`
+	Opportunities []arbitrage.Opportunity `json:"opportunities,omitempty"`
+}
+
+// hub fans StreamMessages out to every connected /stream client.
+type hub struct {
+	mu      sync.Mutex
+	clients map[*websocket.Conn]struct{}
+}
+
+func newHub() *hub {
+	return &hub{clients: make(map[*websocket.Conn]struct{})}
+}
+
+func (h *hub) add(conn *websocket.Conn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.clients[conn] = struct{}{}
+}
+
+func (h *hub) remove(conn *websocket.Conn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.clients[conn]; ok {
+		delete(h.clients, conn)
+		conn.Close()
+	}
+}
+
+func (h *hub) broadcast(msg StreamMessage) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for conn := range h.clients {
+		if err := conn.WriteJSON(msg); err != nil {
+			delete(h.clients, conn)
+			conn.Close()
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/henrriusdev/scrapport/scraper"
+)
+
+// handleMarkets serves GET /markets?book=&game=, returning the latest scrape
+// filtered by either or both query parameters.
+func (s *Server) handleMarkets(w http.ResponseWriter, r *http.Request) {
+	book := r.URL.Query().Get("book")
+	game := r.URL.Query().Get("game")
+
+	s.mu.RLock()
+	markets := make([]scraper.Market, 0, len(s.markets))
+	for _, m := range s.markets {
+		if book != "" && m.Book != book {
+			continue
+		}
+		if game != "" && m.Game != game {
+			continue
+		}
+		markets = append(markets, m)
+	}
+	s.mu.RUnlock()
+
+	writeJSON(w, markets)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,70 @@
+// Package server exposes the scraper's live markets, historical odds, and
+// detected arbitrage opportunities over a REST + WebSocket API so other
+// clients (bots, dashboards) can integrate against it.
+package server
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/mux"
+
+	"github.com/henrriusdev/scrapport/arbitrage"
+	"github.com/henrriusdev/scrapport/scraper"
+	"github.com/henrriusdev/scrapport/storage"
+)
+
+// Server holds the latest scrape results in memory and answers REST/WebSocket
+// requests against them, delegating historical queries to a Store.
+type Server struct {
+	store storage.Store
+	hub   *hub
+	auth  *Authenticator
+
+	mu            sync.RWMutex
+	markets       []scraper.Market
+	opportunities []arbitrage.Opportunity
+}
+
+// NewServer builds a Server backed by store, authenticating requests with auth.
+func NewServer(store storage.Store, auth *Authenticator) *Server {
+	return &Server{store: store, hub: newHub(), auth: auth}
+}
+
+// PushMarkets replaces the server's live market snapshot and streams it to
+// every connected WebSocket client. Call this once per scrape tick.
+func (s *Server) PushMarkets(markets []scraper.Market) {
+	s.mu.Lock()
+	s.markets = markets
+	s.mu.Unlock()
+
+	s.hub.broadcast(StreamMessage{Type: "markets", Markets: markets})
+}
+
+// PushOpportunities replaces the server's live arbitrage snapshot and streams
+// it to every connected WebSocket client. Call this once per scrape tick.
+func (s *Server) PushOpportunities(opportunities []arbitrage.Opportunity) {
+	s.mu.Lock()
+	s.opportunities = opportunities
+	s.mu.Unlock()
+
+	s.hub.broadcast(StreamMessage{Type: "arbitrage", Opportunities: opportunities})
+}
+
+// Handler builds the server's route table: public OpenAPI spec and token
+// endpoint, JWT-protected everything else.
+func (s *Server) Handler() http.Handler {
+	r := mux.NewRouter()
+
+	r.HandleFunc("/openapi.yaml", s.handleOpenAPISpec).Methods(http.MethodGet)
+	r.HandleFunc("/token", s.handleToken).Methods(http.MethodPost)
+
+	api := r.PathPrefix("/").Subrouter()
+	api.Use(s.auth.Middleware)
+	api.HandleFunc("/markets", s.handleMarkets).Methods(http.MethodGet)
+	api.HandleFunc("/history/{game}/{betType}", s.handleHistory).Methods(http.MethodGet)
+	api.HandleFunc("/arbs", s.handleArbs).Methods(http.MethodGet)
+	api.HandleFunc("/stream", s.handleStream).Methods(http.MethodGet)
+
+	return r
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gorilla/websocket"
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// handleStream upgrades GET /stream to a WebSocket and pushes every
+// subsequent PushMarkets/PushOpportunities call to the client as JSON until
+// it disconnects.
+func (s *Server) handleStream(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Printf("server: websocket upgrade: %v\n", err)
+		return
+	}
+
+	s.hub.add(conn)
+	defer s.hub.remove(conn)
+
+	// Drain and discard client messages; this keeps the connection's read
+	// deadline honored and detects disconnects.
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
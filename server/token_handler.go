@@ -0,0 +1,40 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// tokenRequest is the body POST /token expects: an API key proving the
+// caller is allowed to mint tokens, and a subject to embed in the JWT.
+type tokenRequest struct {
+	APIKey  string `json:"api_key"`
+	Subject string `json:"subject"`
+}
+
+// handleToken serves POST /token, issuing a bearer token for subject once
+// the caller proves it holds the server's API key. Unauthenticated by
+// design: it's the only way a legitimate client can get a token at all.
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	var req tokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.Subject == "" {
+		http.Error(w, "subject is required", http.StatusBadRequest)
+		return
+	}
+	if !s.auth.ValidAPIKey(req.APIKey) {
+		http.Error(w, "invalid api key", http.StatusUnauthorized)
+		return
+	}
+
+	token, err := s.auth.IssueToken(req.Subject)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, map[string]string{"token": token})
+}
@@ -0,0 +1,19 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/henrriusdev/scrapport/scraper"
+)
+
+// MarketKey returns the stable identifier used to group a market's history
+// across books and scrape ticks: game, bet type, and side. Book, odds, and line
+// are deliberately excluded since they're what varies between the rows a given
+// key groups together.
+func MarketKey(m scraper.Market) string {
+	return marketKeyFor(m.Game, m.BetType, m.Side)
+}
+
+func marketKeyFor(game, betType, side string) string {
+	return fmt.Sprintf("%s|%s|%s", game, betType, side)
+}
@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "github.com/lib/pq"
+
+	"github.com/henrriusdev/scrapport/scraper"
+)
+
+const postgresSchema = `
+CREATE TABLE IF NOT EXISTS markets (
+	id BIGSERIAL PRIMARY KEY,
+	market_key TEXT NOT NULL,
+	book TEXT NOT NULL,
+	game TEXT NOT NULL,
+	bet_type TEXT NOT NULL,
+	side TEXT NOT NULL,
+	odds DOUBLE PRECISION NOT NULL,
+	line DOUBLE PRECISION NOT NULL,
+	observed_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_markets_key_time ON markets (market_key, observed_at);
+`
+
+// PostgresStore persists markets to a Postgres database.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore opens a Postgres database using connStr (a standard
+// "postgres://" DSN) and ensures its schema exists.
+func NewPostgresStore(connStr string) (*PostgresStore, error) {
+	db, err := sql.Open("postgres", connStr)
+	if err != nil {
+		return nil, fmt.Errorf("storage: opening postgres: %w", err)
+	}
+
+	if _, err := db.Exec(postgresSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("storage: creating schema: %w", err)
+	}
+
+	return &PostgresStore{db: db}, nil
+}
+
+// SaveMarket persists m, tagging it with observedAt.
+func (s *PostgresStore) SaveMarket(ctx context.Context, m scraper.Market, observedAt time.Time) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO markets (market_key, book, game, bet_type, side, odds, line, observed_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		MarketKey(m), m.Book, m.Game, m.BetType, m.Side, m.Odds, m.Line, observedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("storage: saving market: %w", err)
+	}
+	return nil
+}
+
+// LineHistory returns every point recorded for game+betType+side since the given time.
+func (s *PostgresStore) LineHistory(ctx context.Context, game, betType, side string, since time.Time) ([]LinePoint, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT book, line, odds, observed_at FROM markets
+		 WHERE market_key = $1 AND observed_at >= $2
+		 ORDER BY observed_at ASC`,
+		marketKeyFor(game, betType, side), since,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("storage: querying line history: %w", err)
+	}
+	defer rows.Close()
+
+	return scanLineHistory(rows)
+}
+
+// Close releases the underlying database connection.
+func (s *PostgresStore) Close() error {
+	return s.db.Close()
+}
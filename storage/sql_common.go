@@ -0,0 +1,23 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// scanLineHistory reads every row of a `SELECT book, line, odds, observed_at`
+// query into LinePoints, shared by the SQLite and Postgres stores since both
+// query the same shape of row.
+func scanLineHistory(rows *sql.Rows) ([]LinePoint, error) {
+	var points []LinePoint
+
+	for rows.Next() {
+		var p LinePoint
+		if err := rows.Scan(&p.Book, &p.Line, &p.Odds, &p.ObservedAt); err != nil {
+			return nil, fmt.Errorf("storage: scanning row: %w", err)
+		}
+		points = append(points, p)
+	}
+
+	return points, rows.Err()
+}
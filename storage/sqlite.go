@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/henrriusdev/scrapport/scraper"
+)
+
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS markets (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	market_key TEXT NOT NULL,
+	book TEXT NOT NULL,
+	game TEXT NOT NULL,
+	bet_type TEXT NOT NULL,
+	side TEXT NOT NULL,
+	odds REAL NOT NULL,
+	line REAL NOT NULL,
+	observed_at DATETIME NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_markets_key_time ON markets (market_key, observed_at);
+`
+
+// SQLiteStore persists markets to a local SQLite database file.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteStore opens (and, if needed, creates) a SQLite database at path.
+func NewSQLiteStore(path string) (*SQLiteStore, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("storage: opening sqlite: %w", err)
+	}
+
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("storage: creating schema: %w", err)
+	}
+
+	return &SQLiteStore{db: db}, nil
+}
+
+// SaveMarket persists m, tagging it with observedAt.
+func (s *SQLiteStore) SaveMarket(ctx context.Context, m scraper.Market, observedAt time.Time) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO markets (market_key, book, game, bet_type, side, odds, line, observed_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		MarketKey(m), m.Book, m.Game, m.BetType, m.Side, m.Odds, m.Line, observedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("storage: saving market: %w", err)
+	}
+	return nil
+}
+
+// LineHistory returns every point recorded for game+betType+side since the given time.
+func (s *SQLiteStore) LineHistory(ctx context.Context, game, betType, side string, since time.Time) ([]LinePoint, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT book, line, odds, observed_at FROM markets
+		 WHERE market_key = ? AND observed_at >= ?
+		 ORDER BY observed_at ASC`,
+		marketKeyFor(game, betType, side), since,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("storage: querying line history: %w", err)
+	}
+	defer rows.Close()
+
+	return scanLineHistory(rows)
+}
+
+// Close releases the underlying database connection.
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,28 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/henrriusdev/scrapport/scraper"
+)
+
+// Store persists scraped markets and answers historical queries over them.
+type Store interface {
+	// SaveMarket persists m, tagging it with the time it was observed.
+	SaveMarket(ctx context.Context, m scraper.Market, observedAt time.Time) error
+	// LineHistory returns every recorded point for game+betType+side observed
+	// since the given time, oldest first.
+	LineHistory(ctx context.Context, game, betType, side string, since time.Time) ([]LinePoint, error)
+	// Close releases the underlying connection.
+	Close() error
+}
+
+// LinePoint is one historical observation of a market's line and odds at a
+// single book.
+type LinePoint struct {
+	Book       string
+	Line       float64
+	Odds       float64
+	ObservedAt time.Time
+}
@@ -0,0 +1,204 @@
+// Package ui renders a live-updating terminal dashboard of scraped markets,
+// replacing the plain log-spam output of earlier versions.
+package ui
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/henrriusdev/scrapport/scraper"
+)
+
+// SortMode selects how the table's rows are ordered.
+type SortMode int
+
+const (
+	SortByGame SortMode = iota
+	SortByOdds
+	sortModeCount
+)
+
+// String names the sort mode for the header line.
+func (s SortMode) String() string {
+	switch s {
+	case SortByOdds:
+		return "odds"
+	default:
+		return "game"
+	}
+}
+
+// row is one table line: a market plus its line movement since the previous tick.
+type row struct {
+	scraper.Market
+	delta float64
+}
+
+// Dashboard is a tcell-based table of live markets with color-coded line
+// movement, a header showing scrape latency and next-refresh countdown, and a
+// line-editor prompt for filtering by team, bet type, or minimum edge.
+//
+// Keybindings: s sorts, / filters, p pauses, q quits.
+type Dashboard struct {
+	screen tcell.Screen
+
+	mu       sync.Mutex
+	rows     []row
+	prevLine map[string]float64 // per-row key -> last seen line, for movement coloring
+
+	filter   string
+	filterOn bool
+	sort     SortMode
+	paused   bool
+	latency  time.Duration
+	nextTick time.Time
+
+	done chan struct{}
+}
+
+// NewDashboard initializes the terminal screen for the dashboard.
+func NewDashboard() (*Dashboard, error) {
+	screen, err := tcell.NewScreen()
+	if err != nil {
+		return nil, err
+	}
+	if err := screen.Init(); err != nil {
+		return nil, err
+	}
+	screen.SetStyle(tcell.StyleDefault)
+	screen.Clear()
+
+	return &Dashboard{
+		screen:   screen,
+		prevLine: make(map[string]float64),
+		done:     make(chan struct{}),
+	}, nil
+}
+
+// Close tears down the terminal screen, restoring the caller's terminal.
+func (d *Dashboard) Close() {
+	d.screen.Fini()
+}
+
+// Done is closed once the dashboard's event loop exits, whether because the
+// user pressed q or ctx was canceled.
+func (d *Dashboard) Done() <-chan struct{} {
+	return d.done
+}
+
+// Paused reports whether the user has paused the display with p. The scraper
+// loop should skip pushing updates while paused rather than stop scraping.
+func (d *Dashboard) Paused() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.paused
+}
+
+// Update replaces the displayed markets with a fresh scrape tick, computing
+// per-row line movement against the previous tick, and redraws.
+func (d *Dashboard) Update(markets []scraper.Market, latency time.Duration, nextTick time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.latency = latency
+	d.nextTick = nextTick
+
+	rows := make([]row, 0, len(markets))
+	for _, m := range markets {
+		key := rowKey(m)
+		delta := m.Line - d.prevLine[key]
+		d.prevLine[key] = m.Line
+		rows = append(rows, row{Market: m, delta: delta})
+	}
+	d.rows = rows
+
+	d.draw()
+}
+
+func rowKey(m scraper.Market) string {
+	return m.Book + "|" + m.Game + "|" + m.BetType + "|" + m.Side
+}
+
+// Run pumps terminal events until the user quits or ctx is canceled. Run
+// until it returns before expecting the terminal to be usable again.
+func (d *Dashboard) Run(ctx context.Context) {
+	defer close(d.done)
+
+	events := make(chan tcell.Event)
+	go func() {
+		for {
+			ev := d.screen.PollEvent()
+			if ev == nil {
+				return
+			}
+			events <- ev
+		}
+	}()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev := <-events:
+			if d.handleEvent(ev) {
+				return
+			}
+		case <-ticker.C:
+			d.mu.Lock()
+			d.draw()
+			d.mu.Unlock()
+		}
+	}
+}
+
+func (d *Dashboard) handleEvent(ev tcell.Event) (quit bool) {
+	switch e := ev.(type) {
+	case *tcell.EventResize:
+		d.mu.Lock()
+		d.screen.Sync()
+		d.mu.Unlock()
+	case *tcell.EventKey:
+		return d.handleKey(e)
+	}
+	return false
+}
+
+func (d *Dashboard) handleKey(e *tcell.EventKey) (quit bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	defer d.draw()
+
+	if d.filterOn {
+		switch e.Key() {
+		case tcell.KeyEnter, tcell.KeyEscape:
+			d.filterOn = false
+		case tcell.KeyBackspace, tcell.KeyBackspace2:
+			if len(d.filter) > 0 {
+				d.filter = d.filter[:len(d.filter)-1]
+			}
+		case tcell.KeyRune:
+			d.filter += string(e.Rune())
+		}
+		return false
+	}
+
+	switch e.Rune() {
+	case 'q':
+		return true
+	case 's':
+		d.sort = (d.sort + 1) % sortModeCount
+	case 'p':
+		d.paused = !d.paused
+	case '/':
+		d.filterOn = true
+		d.filter = ""
+	}
+
+	return false
+}
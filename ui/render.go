@@ -0,0 +1,133 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+var headerStyle = tcell.StyleDefault.Bold(true)
+
+// draw repaints the whole screen. Callers must hold d.mu.
+func (d *Dashboard) draw() {
+	d.screen.Clear()
+	w, h := d.screen.Size()
+
+	d.drawHeader(w)
+	d.drawTable(w, h)
+	if d.filterOn {
+		d.drawPrompt(w, h)
+	}
+
+	d.screen.Show()
+}
+
+func (d *Dashboard) drawHeader(w int) {
+	remaining := time.Until(d.nextTick)
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	header := fmt.Sprintf(" scrapport | latency %v | next refresh in %v | sort:%s",
+		d.latency.Round(time.Millisecond), remaining.Round(time.Second), d.sort)
+	if d.paused {
+		header += " | PAUSED"
+	}
+
+	drawText(d.screen, 0, 0, w, headerStyle, header)
+}
+
+func (d *Dashboard) drawTable(w, h int) {
+	rows := d.filteredSortedRows()
+
+	y := 2
+	for _, r := range rows {
+		if y >= h {
+			break
+		}
+
+		style := tcell.StyleDefault
+		switch {
+		case r.delta > 0:
+			style = style.Foreground(tcell.ColorGreen)
+		case r.delta < 0:
+			style = style.Foreground(tcell.ColorRed)
+		}
+
+		line := fmt.Sprintf("%-28s | %-10s | %-10s | %-5s | line %6.1f | odds %+6.0f",
+			truncate(r.Game, 28), r.Book, r.BetType, r.Side, r.Line, r.Odds)
+		drawText(d.screen, 0, y, w, style, line)
+		y++
+	}
+}
+
+func (d *Dashboard) drawPrompt(w, h int) {
+	prompt := "/" + d.filter
+	drawText(d.screen, 0, h-1, w, tcell.StyleDefault.Reverse(true), prompt)
+	d.screen.ShowCursor(len(prompt), h-1)
+}
+
+// filteredSortedRows returns the rows to display: matching the current filter
+// and in the current sort order. Callers must hold d.mu.
+func (d *Dashboard) filteredSortedRows() []row {
+	needle := strings.ToLower(strings.TrimSpace(d.filter))
+
+	filtered := make([]row, 0, len(d.rows))
+	for _, r := range d.rows {
+		if needle == "" || matchesFilter(r, needle) {
+			filtered = append(filtered, r)
+		}
+	}
+
+	switch d.sort {
+	case SortByOdds:
+		sort.Slice(filtered, func(i, j int) bool { return filtered[i].Odds > filtered[j].Odds })
+	default:
+		sort.Slice(filtered, func(i, j int) bool {
+			if filtered[i].Game != filtered[j].Game {
+				return filtered[i].Game < filtered[j].Game
+			}
+			return filtered[i].BetType < filtered[j].BetType
+		})
+	}
+
+	return filtered
+}
+
+// matchesFilter matches needle against team/game, bet type, or (via an
+// "edge:N" prefix) a minimum absolute line movement since the last tick.
+func matchesFilter(r row, needle string) bool {
+	if v, ok := strings.CutPrefix(needle, "edge:"); ok {
+		min, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return true
+		}
+		return math.Abs(r.delta) >= min
+	}
+
+	return strings.Contains(strings.ToLower(r.Game), needle) ||
+		strings.Contains(strings.ToLower(r.BetType), needle)
+}
+
+func drawText(screen tcell.Screen, x, y, maxWidth int, style tcell.Style, text string) {
+	col := x
+	for _, ch := range text {
+		if col >= maxWidth {
+			break
+		}
+		screen.SetContent(col, y, ch, nil, style)
+		col++
+	}
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n-1] + "…"
+}